@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareTunnelRoutes() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareTunnelRoutesSchema(),
+		ReadContext: dataSourceCloudflareTunnelRoutesRead,
+	}
+}
+
+func dataSourceCloudflareTunnelRoutesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"tunnel_id": {
+			Description: "The id of the tunnel to filter routes by.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"comment": {
+			Description: "The comment to filter routes by.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"is_deleted": {
+			Description: "Whether to filter routes by `deleted_at`.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"network_subset": {
+			Description: "If set, only return routes that are subsets of the given CIDR.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"network_superset": {
+			Description: "If set, only return routes that are supersets of the given CIDR.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"virtual_network_id": {
+			Description: "If set, only return routes that belong to the given virtual network.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"existed_at": {
+			Description: "If set, only return routes that were active at the given time, in RFC3339 format.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"routes": {
+			Description: "The list of Tunnel Routes matching the given filters.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "UUID of the route.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"network": {
+						Description: "The IPv4 or IPv6 network that should route through the tunnel, in CIDR notation.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"tunnel_id": {
+						Description: "UUID of the tunnel.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"tunnel_name": {
+						Description: "Name of the tunnel.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"comment": {
+						Description: "Description of the tunnel route.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"virtual_network_id": {
+						Description: "UUID of the virtual network this route belongs to.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"created_at": {
+						Description: "Timestamp of when the route was created.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"deleted_at": {
+						Description: "Timestamp of when the route was deleted, if it has been deleted.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareTunnelRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	params := cloudflare.TunnelRoutesListParams{
+		AccountID:        accountID,
+		TunnelID:         d.Get("tunnel_id").(string),
+		Comment:          d.Get("comment").(string),
+		NetworkSubset:    d.Get("network_subset").(string),
+		NetworkSuperset:  d.Get("network_superset").(string),
+		VirtualNetworkID: d.Get("virtual_network_id").(string),
+	}
+
+	if v, ok := d.GetOkExists("is_deleted"); ok {
+		params.IsDeleted = cloudflare.BoolPtr(v.(bool))
+	}
+
+	if existedAt, ok := d.GetOk("existed_at"); ok {
+		t, err := time.Parse(time.RFC3339, existedAt.(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error parsing existed_at %q: %w", existedAt.(string), err))
+		}
+		params.ExistedAt = &t
+	}
+
+	tunnelRoutes, err := client.ListTunnelRoutes(ctx, params)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to fetch Tunnel Routes: %w", err))
+	}
+
+	routes := make([]interface{}, 0, len(tunnelRoutes))
+	for _, route := range tunnelRoutes {
+		r := map[string]interface{}{
+			"id":                 route.ID,
+			"network":            route.Network,
+			"tunnel_id":          route.TunnelID,
+			"tunnel_name":        route.TunnelName,
+			"comment":            route.Comment,
+			"virtual_network_id": route.VirtualNetworkID,
+		}
+
+		if route.CreatedAt != nil {
+			r["created_at"] = route.CreatedAt.Format(time.RFC3339)
+		}
+		if route.DeletedAt != nil {
+			r["deleted_at"] = route.DeletedAt.Format(time.RFC3339)
+		}
+
+		routes = append(routes, r)
+	}
+
+	if err := d.Set("routes", routes); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting routes: %w", err))
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return nil
+}
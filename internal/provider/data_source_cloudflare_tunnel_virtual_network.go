@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareTunnelVirtualNetwork() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareTunnelVirtualNetworkSchema(),
+		ReadContext: dataSourceCloudflareTunnelVirtualNetworkRead,
+	}
+}
+
+func dataSourceCloudflareTunnelVirtualNetworkSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Description: "A user-friendly name used to identify the virtual network.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"is_default_network": {
+			Description: "Whether this virtual network is the default one for the account.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"comment": {
+			Description: "Description of the virtual network.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func dataSourceCloudflareTunnelVirtualNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	name := d.Get("name").(string)
+
+	vnets, err := client.ListTunnelVirtualNetworks(ctx, accountID, cloudflare.TunnelVirtualNetworksListParams{
+		Name: name,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to fetch Tunnel Virtual Network: %w", err))
+	}
+
+	if len(vnets) < 1 {
+		return diag.FromErr(fmt.Errorf("no Tunnel Virtual Network found with name %q in account %q", name, accountID))
+	}
+
+	if len(vnets) > 1 {
+		return diag.FromErr(fmt.Errorf("more than one Tunnel Virtual Network found with name %q in account %q", name, accountID))
+	}
+
+	vnet := vnets[0]
+
+	d.SetId(vnet.ID)
+	d.Set("comment", vnet.Comment)
+	d.Set("is_default_network", vnet.IsDefaultNetwork)
+
+	return nil
+}
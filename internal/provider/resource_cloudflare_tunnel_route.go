@@ -22,36 +22,94 @@ func resourceCloudflareTunnelRoute() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareTunnelRouteImport,
 		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceCloudflareTunnelRouteV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceCloudflareTunnelRouteStateUpgradeV0,
+				Version: 0,
+			},
+		},
 	}
 }
 
-func resourceCloudflareTunnelRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
-	accountID := d.Get("account_id").(string)
-	network := d.Get("network").(string)
-	virtualNetworkID := d.Get("virtual_network_id").(string)
+// resourceCloudflareTunnelRouteV0 describes the schema as it existed before
+// routes were keyed by UUID. The attributes themselves haven't changed, only
+// the meaning of d.Id(), so this just gives StateUpgraders a type to upgrade
+// from.
+func resourceCloudflareTunnelRouteV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: resourceCloudflareTunnelRouteSchema(),
+	}
+}
+
+// resourceCloudflareTunnelRouteStateUpgradeV0 rewrites resources that were
+// created/imported before routes were addressable by UUID. Their d.Id() was
+// the raw network CIDR (optionally with a virtual_network_id suffix), which
+// GetTunnelRoute can't resolve, so every pre-existing route would otherwise
+// 404 on the first Read after this upgrade and Terraform would plan to
+// recreate it. Any id containing a slash (as every old CIDR-based id does) is
+// treated as the old format and re-keyed via the same list-and-filter lookup
+// used by legacy imports; any other id is left as it is.
+func resourceCloudflareTunnelRouteStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	id, _ := rawState["id"].(string)
+	if !strings.Contains(id, "/") {
+		// Already a route UUID; nothing to upgrade.
+		return rawState, nil
+	}
+
+	accountID, _ := rawState["account_id"].(string)
+	network, _ := rawState["network"].(string)
+	virtualNetworkID, _ := rawState["virtual_network_id"].(string)
 
-	resource := cloudflare.TunnelRoutesListParams{
+	routeID, err := lookupTunnelRouteID(ctx, meta.(*cloudflare.API), accountID, network, virtualNetworkID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upgrade state for Tunnel Route %q: %w", id, err)
+	}
+
+	rawState["id"] = routeID
+
+	return rawState, nil
+}
+
+// lookupTunnelRouteID resolves a route's UUID from its (network, virtual
+// network) identity, for state upgrades and legacy imports that predate
+// routes being addressable by ID.
+func lookupTunnelRouteID(ctx context.Context, client *cloudflare.API, accountID, network, virtualNetworkID string) (string, error) {
+	tunnelRoutes, err := client.ListTunnelRoutes(ctx, cloudflare.TunnelRoutesListParams{
 		AccountID:        accountID,
 		IsDeleted:        cloudflare.BoolPtr(false),
 		NetworkSubset:    network,
 		NetworkSuperset:  network,
 		VirtualNetworkID: virtualNetworkID,
-	}
-
-	tunnelRoutes, err := client.ListTunnelRoutes(ctx, resource)
-
+	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to fetch Tunnel Route: %w", err))
+		return "", fmt.Errorf("failed to fetch Tunnel Route for Network %q: %w", network, err)
 	}
 
 	if len(tunnelRoutes) < 1 {
-		tflog.Info(ctx, fmt.Sprintf("Tunnel Route for network %s in account %s not found", network, accountID))
-		d.SetId("")
-		return nil
+		return "", fmt.Errorf("no Tunnel Route found for Network %q in account %q", network, accountID)
 	}
 
-	tunnelRoute := tunnelRoutes[0]
+	return tunnelRoutes[0].ID, nil
+}
+
+func resourceCloudflareTunnelRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tunnelRoute, err := client.GetTunnelRoute(ctx, cloudflare.GetTunnelRouteParams{
+		AccountID: accountID,
+		RouteID:   d.Id(),
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			tflog.Info(ctx, fmt.Sprintf("Tunnel Route %s in account %s not found", d.Id(), accountID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to fetch Tunnel Route: %w", err))
+	}
 
 	d.Set("tunnel_id", tunnelRoute.TunnelID)
 	d.Set("network", tunnelRoute.Network)
@@ -62,7 +120,7 @@ func resourceCloudflareTunnelRouteRead(ctx context.Context, d *schema.ResourceDa
 	// Virtual network id is optional. API always returns it. Do not set it unless it was specified explicitly.
 	// Othewise if route was created by old provider it will trigger redundant state changes.
 	// Old provider did not support virtual network ids at all.
-	if virtualNetworkID != "" {
+	if d.Get("virtual_network_id").(string) != "" {
 		d.Set("virtual_network_id", tunnelRoute.VirtualNetworkID)
 	}
 
@@ -89,12 +147,7 @@ func resourceCloudflareTunnelRouteCreate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(fmt.Errorf("error creating Tunnel Route for Network %q: %w", d.Get("network").(string), err))
 	}
 
-	if virtualNetworkID != "" {
-		// It's possible to create several routes with the same network but different virtual network ids.
-		d.SetId(fmt.Sprintf("%s/%s", newTunnelRoute.Network, virtualNetworkID))
-	} else {
-		d.SetId(newTunnelRoute.Network)
-	}
+	d.SetId(newTunnelRoute.ID)
 
 	return resourceCloudflareTunnelRouteRead(ctx, d, meta)
 }
@@ -124,45 +177,78 @@ func resourceCloudflareTunnelRouteUpdate(ctx context.Context, d *schema.Resource
 
 func resourceCloudflareTunnelRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
-	network := d.Get("network").(string)
 
-	resource := cloudflare.TunnelRoutesDeleteParams{
-		AccountID:        d.Get("account_id").(string),
-		Network:          network,
-		VirtualNetworkID: d.Get("virtual_network_id").(string),
-	}
-
-	err := client.DeleteTunnelRoute(ctx, resource)
+	err := client.DeleteTunnelRoute(ctx, cloudflare.DeleteTunnelRouteParams{
+		AccountID: d.Get("account_id").(string),
+		RouteID:   d.Id(),
+	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting Tunnel Route for Network %q: %w", network, err))
+		return diag.FromErr(fmt.Errorf("error deleting Tunnel Route %q: %w", d.Id(), err))
 	}
 
 	return nil
 }
 
+// resourceCloudflareTunnelRouteImport accepts either the current "accountID/routeID"
+// form or the legacy "accountID/network[/virtual_network_id]" form used before routes
+// were addressable by a stable UUID. Legacy imports fall back to a list-and-filter
+// lookup, and the imported state is keyed by the route UUID that lookup finds.
 func resourceCloudflareTunnelRouteImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	attributes := strings.SplitN(d.Id(), "/", 4)
 
-	// network is a CIDR that always contains slash inside. For example "192.168.0.0/26"
+	if len(attributes) == 2 {
+		accountID, routeID := attributes[0], attributes[1]
+
+		client := meta.(*cloudflare.API)
+		tunnelRoute, err := client.GetTunnelRoute(ctx, cloudflare.GetTunnelRouteParams{
+			AccountID: accountID,
+			RouteID:   routeID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch Tunnel Route %q: %w", routeID, err)
+		}
+
+		d.SetId(routeID)
+		d.Set("account_id", accountID)
+		// Pre-seed virtual_network_id from the API response so the read-time
+		// guard (which only surfaces it once it's already present in state)
+		// doesn't drop it for routes that belong to a non-default vnet.
+		if tunnelRoute.VirtualNetworkID != "" {
+			d.Set("virtual_network_id", tunnelRoute.VirtualNetworkID)
+		}
+
+		if err := resourceCloudflareTunnelRouteRead(ctx, d, meta); err != nil {
+			return nil, errors.New("failed to read Tunnel Route state")
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+
+	// Legacy "accountID/network" or "accountID/network/virtual_network_id" form.
+	// network is a CIDR that always contains a slash, e.g. "192.168.0.0/26".
 	if len(attributes) != 3 && len(attributes) != 4 {
-		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/network" or "accountID/network/virtual_network_id"`, d.Id())
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/routeID" or "accountID/network[/virtual_network_id]"`, d.Id())
 	}
 
 	accountID, network := attributes[0], fmt.Sprintf("%s/%s", attributes[1], attributes[2])
-
+	virtualNetworkID := ""
 	if len(attributes) == 4 {
-		// It's possible to create several routes with the same network but different virtual network ids.
-		d.SetId(fmt.Sprintf("%s/%s", network, attributes[4]))
-		d.Set("virtual_network_id", accountID)
-	} else {
-		d.SetId(network)
+		virtualNetworkID = attributes[3]
 	}
 
+	routeID, err := lookupTunnelRouteID(ctx, meta.(*cloudflare.API), accountID, network, virtualNetworkID)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(routeID)
 	d.Set("account_id", accountID)
 	d.Set("network", network)
+	if virtualNetworkID != "" {
+		d.Set("virtual_network_id", virtualNetworkID)
+	}
 
-	err := resourceCloudflareTunnelRouteRead(ctx, d, meta)
-	if err != nil {
+	if err := resourceCloudflareTunnelRouteRead(ctx, d, meta); err != nil {
 		return nil, errors.New("failed to read Tunnel Route state")
 	}
 
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareTunnelVirtualNetwork() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareTunnelVirtualNetworkSchema(),
+		CreateContext: resourceCloudflareTunnelVirtualNetworkCreate,
+		ReadContext:   resourceCloudflareTunnelVirtualNetworkRead,
+		UpdateContext: resourceCloudflareTunnelVirtualNetworkUpdate,
+		DeleteContext: resourceCloudflareTunnelVirtualNetworkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareTunnelVirtualNetworkImport,
+		},
+	}
+}
+
+func resourceCloudflareTunnelVirtualNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	newVnet, err := client.CreateTunnelVirtualNetwork(ctx, accountID, cloudflare.TunnelVirtualNetworkRequest{
+		Name:             d.Get("name").(string),
+		Comment:          d.Get("comment").(string),
+		IsDefaultNetwork: d.Get("is_default_network").(bool),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Tunnel Virtual Network %q: %w", d.Get("name").(string), err))
+	}
+
+	d.SetId(newVnet.ID)
+
+	return resourceCloudflareTunnelVirtualNetworkRead(ctx, d, meta)
+}
+
+func resourceCloudflareTunnelVirtualNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	vnets, err := client.ListTunnelVirtualNetworks(ctx, accountID, cloudflare.TunnelVirtualNetworksListParams{})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to fetch Tunnel Virtual Network: %w", err))
+	}
+
+	for _, vnet := range vnets {
+		if vnet.ID != d.Id() {
+			continue
+		}
+
+		d.Set("name", vnet.Name)
+		d.Set("comment", vnet.Comment)
+		d.Set("is_default_network", vnet.IsDefaultNetwork)
+
+		return nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Tunnel Virtual Network %s in account %s not found", d.Id(), accountID))
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareTunnelVirtualNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	_, err := client.UpdateTunnelVirtualNetwork(ctx, accountID, d.Id(), cloudflare.TunnelVirtualNetworkRequest{
+		Name:             d.Get("name").(string),
+		Comment:          d.Get("comment").(string),
+		IsDefaultNetwork: d.Get("is_default_network").(bool),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Tunnel Virtual Network %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareTunnelVirtualNetworkRead(ctx, d, meta)
+}
+
+func resourceCloudflareTunnelVirtualNetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	err := client.DeleteTunnelVirtualNetwork(ctx, accountID, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Tunnel Virtual Network %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareTunnelVirtualNetworkImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/vnetID"`, d.Id())
+	}
+
+	accountID, vnetID := attributes[0], attributes[1]
+
+	d.SetId(vnetID)
+	d.Set("account_id", accountID)
+
+	if err := resourceCloudflareTunnelVirtualNetworkRead(ctx, d, meta); err != nil {
+		return nil, errors.New("failed to read Tunnel Virtual Network state")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
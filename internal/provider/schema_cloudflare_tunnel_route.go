@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareTunnelRouteSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"tunnel_id": {
+			Description: "The ID of the tunnel that will service the route.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"network": {
+			Description:      "The IPv4 or IPv6 network that should route through the tunnel, in CIDR notation.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validateTunnelRouteNetwork,
+			DiffSuppressFunc: diffSuppressTunnelRouteNetwork,
+		},
+		"comment": {
+			Description: "Description of the tunnel route.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"virtual_network_id": {
+			Description: "The ID of the virtual network the route should belong to. Defaults to the account's default virtual network if not specified.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+		},
+	}
+}
+
+// validateTunnelRouteNetwork ensures the configured network is a well-formed
+// CIDR with no host bits set, e.g. rejecting "192.168.1.5/24" in favour of
+// "192.168.1.0/24". Inputs that are merely non-canonical in their textual
+// representation (leading-zero IPv4 octets, mixed-case IPv6) are accepted
+// rather than rejected: canonicalizeCIDR strips leading zeros from IPv4
+// octets, and net.ParseCIDR accepts mixed-case IPv6 as it is.
+func validateTunnelRouteNetwork(val interface{}, path cty.Path) diag.Diagnostics {
+	network := canonicalizeCIDR(val.(string))
+
+	ip, ipNet, err := net.ParseCIDR(network)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "invalid network",
+			Detail:        fmt.Sprintf("%q is not a valid CIDR: %s", network, err),
+			AttributePath: path,
+		}}
+	}
+
+	if !ip.Equal(ipNet.IP) {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "network has host bits set",
+			Detail:        fmt.Sprintf("%q is not in canonical form, did you mean %q?", network, ipNet.String()),
+			AttributePath: path,
+		}}
+	}
+
+	return nil
+}
+
+// diffSuppressTunnelRouteNetwork suppresses diffs between two CIDRs that
+// normalize to the same canonical form, so plans don't churn over things
+// like leading zeroes or mixed-case IPv6 that the user happened to type.
+func diffSuppressTunnelRouteNetwork(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	oldNetwork, ok := normalizeTunnelRouteNetwork(oldValue)
+	if !ok {
+		return false
+	}
+
+	newNetwork, ok := normalizeTunnelRouteNetwork(newValue)
+	if !ok {
+		return false
+	}
+
+	return oldNetwork == newNetwork
+}
+
+func normalizeTunnelRouteNetwork(network string) (string, bool) {
+	_, ipNet, err := net.ParseCIDR(canonicalizeCIDR(network))
+	if err != nil {
+		return "", false
+	}
+
+	return ipNet.String(), true
+}
+
+// canonicalizeCIDR rewrites the textual IPv4 portion of a CIDR so that each
+// octet has no leading zeros, e.g. "192.168.001.0/24" becomes
+// "192.168.1.0/24". net.ParseCIDR (Go >=1.17) rejects leading-zero octets
+// outright to avoid octal ambiguity, which would otherwise turn this common
+// typo into a hard validation error instead of the silent normalization the
+// network attribute is meant to provide. IPv6 addresses and malformed input
+// are returned unchanged and left for net.ParseCIDR to accept or reject.
+func canonicalizeCIDR(network string) string {
+	slash := strings.LastIndex(network, "/")
+	if slash < 0 {
+		return network
+	}
+
+	ip, prefix := network[:slash], network[slash+1:]
+	if strings.Contains(ip, ":") {
+		return network
+	}
+
+	octets := strings.Split(ip, ".")
+	if len(octets) != 4 {
+		return network
+	}
+
+	for i, octet := range octets {
+		n, err := strconv.Atoi(octet)
+		if err != nil || n < 0 || n > 255 {
+			return network
+		}
+		octets[i] = strconv.Itoa(n)
+	}
+
+	return strings.Join(octets, ".") + "/" + prefix
+}